@@ -0,0 +1,429 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/talos-systems/go-procfs/procfs"
+	"go.uber.org/zap"
+	"inet.af/netaddr"
+
+	talosconfig "github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1"
+	"github.com/talos-systems/talos/pkg/machinery/nethelpers"
+	"github.com/talos-systems/talos/pkg/resources/config"
+	"github.com/talos-systems/talos/pkg/resources/network"
+)
+
+// LinkConfigController manages network.LinkSpec based on machine configuration,
+// kernel cmdline and some built-in defaults (e.g. the loopback interface).
+type LinkConfigController struct {
+	// Cmdline is the kernel cmdline, used mainly for testing; when nil the
+	// controller reads the real /proc/cmdline.
+	Cmdline *procfs.Cmdline
+}
+
+// Name implements controller.Controller.
+func (ctrl *LinkConfigController) Name() string {
+	return "network.LinkConfigController"
+}
+
+// Inputs implements controller.Controller.
+func (ctrl *LinkConfigController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        optional(config.MachineConfigID),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller.
+func (ctrl *LinkConfigController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: network.LinkSpecType,
+			Kind: controller.OutputShared,
+		},
+	}
+}
+
+func optional(id resource.ID) *resource.ID {
+	return &id
+}
+
+// Run implements controller.Controller.
+func (ctrl *LinkConfigController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	cmdline := ctrl.Cmdline
+	if cmdline == nil {
+		cmdline = procfs.ProcCmdline()
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		touchedIDs := map[string]struct{}{}
+
+		if err := ctrl.apply(ctx, r, network.NewLinkSpec(network.ConfigNamespaceName, linkID(network.ConfigDefault, "lo")), func(spec *network.LinkSpecSpec) {
+			*spec = network.LinkSpecSpec{
+				Name:        "lo",
+				Up:          true,
+				ConfigLayer: network.ConfigDefault,
+			}
+		}); err != nil {
+			return err
+		}
+
+		touchedIDs[linkID(network.ConfigDefault, "lo")] = struct{}{}
+
+		cmdlineIDs, err := ctrl.applyCmdline(ctx, r, cmdline)
+		if err != nil {
+			return fmt.Errorf("error applying cmdline configuration: %w", err)
+		}
+
+		for id := range cmdlineIDs {
+			touchedIDs[id] = struct{}{}
+		}
+
+		cfg, err := r.Get(ctx, resource.NewMetadata(config.NamespaceName, config.MachineConfigType, config.MachineConfigID, resource.VersionUndefined))
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting machine config: %w", err)
+		}
+
+		if err == nil {
+			machineCfgIDs, applyErr := ctrl.applyMachineConfig(ctx, r, cfg.(*config.MachineConfig).Config())
+			if applyErr != nil {
+				return fmt.Errorf("error applying machine configuration: %w", applyErr)
+			}
+
+			for id := range machineCfgIDs {
+				touchedIDs[id] = struct{}{}
+			}
+		}
+
+		if err = ctrl.cleanup(ctx, r, touchedIDs); err != nil {
+			return fmt.Errorf("error cleaning up link specs: %w", err)
+		}
+	}
+}
+
+func linkID(layer network.ConfigLayer, name string) string {
+	return fmt.Sprintf("%s/%s", layer, name)
+}
+
+// apply creates or updates a LinkSpec resource, applying update in place.
+func (ctrl *LinkConfigController) apply(ctx context.Context, r controller.Runtime, spec *network.LinkSpec, update func(*network.LinkSpecSpec)) error {
+	return r.Modify(ctx, spec, func(res resource.Resource) error {
+		update(res.(*network.LinkSpec).Status())
+
+		return nil
+	})
+}
+
+func (ctrl *LinkConfigController) cleanup(ctx context.Context, r controller.Runtime, touchedIDs map[string]struct{}) error {
+	list, err := r.List(ctx, resource.NewMetadata(network.ConfigNamespaceName, network.LinkSpecType, "", resource.VersionUndefined))
+	if err != nil {
+		return err
+	}
+
+	for _, res := range list.Items {
+		if _, ok := touchedIDs[res.Metadata().ID()]; ok {
+			continue
+		}
+
+		if err = r.Destroy(ctx, res.Metadata()); err != nil && !state.IsNotFoundError(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (ctrl *LinkConfigController) applyCmdline(ctx context.Context, r controller.Runtime, cmdline *procfs.Cmdline) (map[string]struct{}, error) {
+	touchedIDs := map[string]struct{}{}
+
+	settings, err := ParseCmdlineNetwork(cmdline)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, link := range settings {
+		link := link
+
+		id := linkID(network.ConfigCmdline, link.Name)
+
+		if err = ctrl.apply(ctx, r, network.NewLinkSpec(network.ConfigNamespaceName, id), func(spec *network.LinkSpecSpec) {
+			*spec = link
+			spec.ConfigLayer = network.ConfigCmdline
+		}); err != nil {
+			return nil, err
+		}
+
+		touchedIDs[id] = struct{}{}
+	}
+
+	return touchedIDs, nil
+}
+
+func (ctrl *LinkConfigController) applyMachineConfig(ctx context.Context, r controller.Runtime, cfg *talosconfig.Config) (map[string]struct{}, error) {
+	touchedIDs := map[string]struct{}{}
+
+	if cfg == nil || cfg.MachineConfig == nil || cfg.MachineConfig.MachineNetwork == nil {
+		return touchedIDs, nil
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid machine configuration: %w", err)
+	}
+
+	masterOf := map[string]string{}
+
+	for _, device := range cfg.MachineConfig.MachineNetwork.NetworkInterfaces {
+		if device.DeviceIgnore {
+			continue
+		}
+
+		if device.DeviceBond != nil {
+			for _, slave := range device.DeviceBond.BondInterfaces {
+				masterOf[slave] = device.DeviceInterface
+			}
+		}
+
+		if device.DeviceBridge != nil {
+			for _, slave := range device.DeviceBridge.BridgeInterfaces {
+				masterOf[slave] = device.DeviceInterface
+			}
+		}
+	}
+
+	for _, device := range cfg.MachineConfig.MachineNetwork.NetworkInterfaces {
+		if device.DeviceIgnore {
+			continue
+		}
+
+		name := device.DeviceInterface
+
+		id := linkID(network.ConfigMachineConfiguration, name)
+
+		master, enslaved := masterOf[name]
+
+		if err := ctrl.apply(ctx, r, network.NewLinkSpec(network.ConfigNamespaceName, id), func(spec *network.LinkSpecSpec) {
+			*spec = network.LinkSpecSpec{
+				Name:        name,
+				Up:          !enslaved,
+				ConfigLayer: network.ConfigMachineConfiguration,
+			}
+
+			if enslaved {
+				spec.MasterName = master
+			}
+		}); err != nil {
+			return nil, err
+		}
+
+		touchedIDs[id] = struct{}{}
+
+		for _, vlan := range device.DeviceVlans {
+			vlanName := fmt.Sprintf("%s.%d", name, vlan.VlanID)
+			vlanID := linkID(network.ConfigMachineConfiguration, vlanName)
+
+			if err := ctrl.apply(ctx, r, network.NewLinkSpec(network.ConfigNamespaceName, vlanID), func(spec *network.LinkSpecSpec) {
+				*spec = network.LinkSpecSpec{
+					Name:        vlanName,
+					Up:          true,
+					Logical:     true,
+					Kind:        network.LinkKindVLAN,
+					Type:        nethelpers.LinkEther,
+					ParentName:  name,
+					ConfigLayer: network.ConfigMachineConfiguration,
+					VLAN: network.VLANSpec{
+						VID:      vlan.VlanID,
+						Protocol: nethelpers.VLANProtocol8021Q,
+					},
+				}
+			}); err != nil {
+				return nil, err
+			}
+
+			touchedIDs[vlanID] = struct{}{}
+		}
+
+		if device.DeviceBond != nil {
+			bond := device.DeviceBond
+			bondID := id
+
+			bondMode, _ := nethelpers.BondModeByName(bond.BondMode)
+			xmitHashPolicy, _ := nethelpers.XmitHashPolicyByName(bond.BondXmitHashPolicy)
+			lacpRate, _ := nethelpers.LACPRateByName(bond.BondLACPRate)
+			adSelect, _ := nethelpers.ADSelectByName(bond.BondADSelect)
+			arpValidate, _ := nethelpers.ARPValidateByName(bond.BondArpValidate)
+			primaryReselect, _ := nethelpers.PrimaryReselectByName(bond.BondPrimaryReselect)
+			failOverMac, _ := nethelpers.FailOverMACByName(bond.BondFailOverMac)
+
+			if err := ctrl.apply(ctx, r, network.NewLinkSpec(network.ConfigNamespaceName, bondID), func(spec *network.LinkSpecSpec) {
+				spec.Logical = true
+				spec.Up = true
+				spec.Kind = network.LinkKindBond
+				spec.Type = nethelpers.LinkEther
+				spec.BondMaster = network.BondMasterSpec{
+					Mode:            bondMode,
+					UseCarrier:      bond.BondUseCarrier == nil || *bond.BondUseCarrier,
+					MIIMon:          bond.BondMIIMon,
+					UpDelay:         bond.BondUpDelay,
+					DownDelay:       bond.BondDownDelay,
+					XmitHashPolicy:  xmitHashPolicy,
+					LACPRate:        lacpRate,
+					ADSelect:        adSelect,
+					ArpInterval:     bond.BondArpInterval,
+					ArpIPTarget:     bond.BondArpIPTarget,
+					ArpValidate:     arpValidate,
+					PrimaryReselect: primaryReselect,
+					FailOverMac:     failOverMac,
+					ResendIGMP:      bond.BondResendIGMP,
+					NumPeerNotif:    bond.BondNumPeerNotif,
+					AllSlavesActive: bond.BondAllSlavesActive,
+					MinLinks:        bond.BondMinLinks,
+					PacketsPerSlave: bond.BondPacketsPerSlave,
+				}
+			}); err != nil {
+				return nil, err
+			}
+		}
+
+		if device.DeviceBridge != nil {
+			bridge := device.DeviceBridge
+			bridgeID := id
+
+			bridgeMaster := network.BridgeMasterSpec{
+				VLANFiltering: bridge.BridgeVLANFiltering,
+			}
+
+			if bridge.BridgeSTP != nil {
+				bridgeMaster.STPEnabled = bridge.BridgeSTP.STPEnabled
+				bridgeMaster.ForwardDelay = bridge.BridgeSTP.STPForwardDelay
+				bridgeMaster.HelloTime = bridge.BridgeSTP.STPHelloTime
+				bridgeMaster.MaxAge = bridge.BridgeSTP.STPMaxAge
+				bridgeMaster.Priority = bridge.BridgeSTP.STPPriority
+			}
+
+			if err := ctrl.apply(ctx, r, network.NewLinkSpec(network.ConfigNamespaceName, bridgeID), func(spec *network.LinkSpecSpec) {
+				spec.Logical = true
+				spec.Up = true
+				spec.Kind = network.LinkKindBridge
+				spec.Type = nethelpers.LinkEther
+				spec.BridgeMaster = bridgeMaster
+			}); err != nil {
+				return nil, err
+			}
+		}
+
+		if device.DeviceMACVlan != nil {
+			macvlan := device.DeviceMACVlan
+
+			mode, _ := nethelpers.MACVlanModeByName(macvlan.MACVlanMode)
+
+			if err := ctrl.apply(ctx, r, network.NewLinkSpec(network.ConfigNamespaceName, id), func(spec *network.LinkSpecSpec) {
+				spec.Logical = true
+				spec.Up = true
+				spec.Kind = network.LinkKindMACVlan
+				spec.Type = nethelpers.LinkEther
+				spec.ParentName = macvlan.MACVlanParent
+				spec.MACVlan = network.MACVlanSpec{
+					Mode: mode,
+				}
+			}); err != nil {
+				return nil, err
+			}
+		}
+
+		if device.DeviceVeth != nil {
+			veth := device.DeviceVeth
+
+			if err := ctrl.apply(ctx, r, network.NewLinkSpec(network.ConfigNamespaceName, id), func(spec *network.LinkSpecSpec) {
+				spec.Logical = true
+				spec.Up = true
+				spec.Kind = network.LinkKindVeth
+				spec.Type = nethelpers.LinkEther
+				spec.Veth = network.VethSpec{
+					PeerName:      veth.VethPeerName,
+					PeerNamespace: veth.VethPeerNamespace,
+				}
+			}); err != nil {
+				return nil, err
+			}
+		}
+
+		if device.DeviceDummy {
+			if err := ctrl.apply(ctx, r, network.NewLinkSpec(network.ConfigNamespaceName, id), func(spec *network.LinkSpecSpec) {
+				spec.Logical = true
+				spec.Kind = network.LinkKindDummy
+				spec.Type = nethelpers.LinkEther
+			}); err != nil {
+				return nil, err
+			}
+		}
+
+		if device.DeviceWireguardConfig != nil {
+			wireguardSpec, err := wireguardSpec(device.DeviceWireguardConfig)
+			if err != nil {
+				return nil, fmt.Errorf("interface %q: %w", name, err)
+			}
+
+			if err = ctrl.apply(ctx, r, network.NewLinkSpec(network.ConfigNamespaceName, id), func(spec *network.LinkSpecSpec) {
+				spec.Logical = true
+				spec.Up = true
+				spec.Kind = network.LinkKindWireguard
+				spec.Type = nethelpers.LinkNone
+				spec.Wireguard = wireguardSpec
+			}); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return touchedIDs, nil
+}
+
+// wireguardSpec translates a v1alpha1 Wireguard device configuration into the
+// network.WireguardSpec consumed by the Wireguard link operator.
+func wireguardSpec(cfg *talosconfig.DeviceWireguardConfig) (network.WireguardSpec, error) {
+	spec := network.WireguardSpec{
+		PrivateKey: cfg.WireguardPrivateKey,
+		ListenPort: cfg.WireguardListenPort,
+	}
+
+	for _, peer := range cfg.WireguardPeers {
+		allowedIPs := make([]netaddr.IPPrefix, 0, len(peer.WireguardAllowedIPs))
+
+		for _, cidr := range peer.WireguardAllowedIPs {
+			prefix, err := netaddr.ParseIPPrefix(cidr)
+			if err != nil {
+				return network.WireguardSpec{}, fmt.Errorf("error parsing allowed IP %q: %w", cidr, err)
+			}
+
+			allowedIPs = append(allowedIPs, prefix)
+		}
+
+		spec.Peers = append(spec.Peers, network.WireguardPeer{
+			PublicKey:                   peer.WireguardPublicKey,
+			PresharedKey:                peer.WireguardPresharedKey,
+			Endpoint:                    peer.WireguardEndpoint,
+			PersistentKeepaliveInterval: peer.WireguardPersistentKeepaliveInterval.Duration(),
+			AllowedIPs:                  allowedIPs,
+		})
+	}
+
+	return spec, nil
+}
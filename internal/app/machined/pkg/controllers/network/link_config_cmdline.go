@@ -0,0 +1,340 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/talos-systems/go-procfs/procfs"
+
+	"github.com/talos-systems/talos/pkg/machinery/nethelpers"
+	"github.com/talos-systems/talos/pkg/resources/network"
+)
+
+// dhcpShortForms are the dracut `ip=<interface>:<form>` keywords which
+// request dynamic addressing instead of a static one, mapped to the
+// resulting DHCP4/DHCP6 LinkSpecSpec flags. `off`/`none` request neither
+// operator, just that the link exists and is up; `any` and `on` mirror
+// `dhcp` (DHCPv4) per dracut's own documented behaviour.
+var dhcpShortForms = map[string]struct {
+	dhcp4 bool
+	dhcp6 bool
+}{
+	"dhcp":  {dhcp4: true},
+	"dhcp6": {dhcp6: true},
+	"any":   {dhcp4: true},
+	"on":    {dhcp4: true},
+	"off":   {},
+	"none":  {},
+}
+
+// ParseCmdlineNetwork translates the dracut-style `ip=`, `vlan=` and `bond=`
+// kernel cmdline arguments into LinkSpecSpecs, one per configured interface.
+//
+// Supported forms:
+//
+//   - multiple `ip=` occurrences, one LinkSpec produced per interface
+//
+//   - `ip=<interface>:dhcp|dhcp6|any|on|off|none[:<mtu>[:<macaddr>]]` short form
+//
+//   - `ip=<client-ip>:<peer>:<gateway-ip>:<netmask>:<hostname>:<device>:...` static form
+//
+//   - `vlan=<vlanname>:<phydevice>`
+//
+//   - `bond=<name>[:<slaves>[:<options>[:<mtu>]]]`
+//
+//   - `bootdev=<interface>` / `rd.route=<net>/<prefix>:<gateway>:<interface>`
+//     hint which interface should carry the default route; the hinted
+//     interface's LinkSpec is marked Primary so a route configuration
+//     controller can act on it
+func ParseCmdlineNetwork(cmdline *procfs.Cmdline) ([]network.LinkSpecSpec, error) {
+	if cmdline == nil {
+		return nil, nil
+	}
+
+	var links []network.LinkSpecSpec
+
+	ipLinks, err := parseIPParameter(cmdline)
+	if err != nil {
+		return nil, err
+	}
+
+	links = append(links, ipLinks...)
+
+	bondSlaves, bondLinks, err := parseBondParameter(cmdline)
+	if err != nil {
+		return nil, err
+	}
+
+	links = append(links, bondLinks...)
+
+	vlanLinks, err := parseVlanParameter(cmdline)
+	if err != nil {
+		return nil, err
+	}
+
+	links = append(links, vlanLinks...)
+
+	if primary, ok := parsePrimaryInterface(cmdline); ok {
+		for i, link := range links {
+			if link.Name == primary {
+				links[i].Primary = true
+			}
+		}
+	}
+
+	seen := make(map[string]struct{}, len(links))
+
+	for i, link := range links {
+		if master, ok := bondSlaves[link.Name]; ok {
+			links[i].Up = false
+			links[i].MasterName = master
+		}
+
+		seen[link.Name] = struct{}{}
+	}
+
+	// A slave named in `bond=` might not have its own `ip=` entry: synthesize
+	// a LinkSpec for it so the bond topology can be declared entirely from
+	// the kernel cmdline.
+	for slave, master := range bondSlaves {
+		if _, ok := seen[slave]; ok {
+			continue
+		}
+
+		links = append(links, network.LinkSpecSpec{
+			Name:       slave,
+			Up:         false,
+			MasterName: master,
+		})
+	}
+
+	return links, nil
+}
+
+// cmdlineValues returns every occurrence of a repeated `key=value` cmdline
+// parameter, e.g. every `ip=...` entry in order.
+func cmdlineValues(cmdline *procfs.Cmdline, key string) []string {
+	param := cmdline.Get(key)
+	if param == nil {
+		return nil
+	}
+
+	var values []string
+
+	for i := 0; ; i++ {
+		value, err := param.Get(i)
+		if err != nil {
+			break
+		}
+
+		values = append(values, value)
+	}
+
+	return values
+}
+
+func parseIPParameter(cmdline *procfs.Cmdline) ([]network.LinkSpecSpec, error) {
+	var links []network.LinkSpecSpec
+
+	for _, value := range cmdlineValues(cmdline, "ip") {
+		fields := strings.Split(value, ":")
+
+		if len(fields) >= 2 {
+			if form, dhcp := dhcpShortForms[fields[1]]; dhcp {
+				if fields[0] == "" {
+					continue
+				}
+
+				link := network.LinkSpecSpec{
+					Name:  fields[0],
+					Up:    true,
+					DHCP4: form.dhcp4,
+					DHCP6: form.dhcp6,
+				}
+
+				// Trailing <mtu>[:<macaddr>] fields; the MAC address is used
+				// to match the interface, not to configure the link, so only
+				// the MTU is relevant here.
+				if len(fields) > 2 && fields[2] != "" {
+					if mtu, err := strconv.ParseUint(fields[2], 10, 32); err == nil {
+						link.MTU = uint32(mtu)
+					}
+				}
+
+				links = append(links, link)
+
+				continue
+			}
+		}
+
+		if len(fields) < 6 || fields[5] == "" {
+			continue
+		}
+
+		links = append(links, network.LinkSpecSpec{
+			Name: fields[5],
+			Up:   true,
+		})
+	}
+
+	return links, nil
+}
+
+// parsePrimaryInterface returns the interface named by `bootdev=`, or
+// failing that the interface named by the last `rd.route=` entry, as the
+// default-route hint.
+func parsePrimaryInterface(cmdline *procfs.Cmdline) (string, bool) {
+	if bootdevs := cmdlineValues(cmdline, "bootdev"); len(bootdevs) > 0 {
+		if name := bootdevs[len(bootdevs)-1]; name != "" {
+			return name, true
+		}
+	}
+
+	routes := cmdlineValues(cmdline, "rd.route")
+	for i := len(routes) - 1; i >= 0; i-- {
+		fields := strings.Split(routes[i], ":")
+		if len(fields) < 3 || fields[2] == "" {
+			continue
+		}
+
+		return fields[2], true
+	}
+
+	return "", false
+}
+
+func parseVlanParameter(cmdline *procfs.Cmdline) ([]network.LinkSpecSpec, error) {
+	var links []network.LinkSpecSpec
+
+	for _, value := range cmdlineValues(cmdline, "vlan") {
+		fields := strings.SplitN(value, ":", 2)
+		if len(fields) != 2 || fields[0] == "" || fields[1] == "" {
+			continue
+		}
+
+		name, phyDevice := fields[0], fields[1]
+
+		vid, ok := vlanIDFromName(name)
+		if !ok {
+			continue
+		}
+
+		links = append(links, network.LinkSpecSpec{
+			Name:       name,
+			Up:         true,
+			Logical:    true,
+			Kind:       network.LinkKindVLAN,
+			Type:       nethelpers.LinkEther,
+			ParentName: phyDevice,
+			VLAN: network.VLANSpec{
+				VID:      vid,
+				Protocol: nethelpers.VLANProtocol8021Q,
+			},
+		})
+	}
+
+	return links, nil
+}
+
+// vlanIDFromName extracts the VLAN ID from a dracut VLAN name, which is
+// expected to be of the form `<phydevice>.<vlanid>` or `vlan<vlanid>`.
+func vlanIDFromName(name string) (uint16, bool) {
+	if idx := strings.LastIndex(name, "."); idx != -1 {
+		if vid, err := strconv.ParseUint(name[idx+1:], 10, 16); err == nil {
+			return uint16(vid), true
+		}
+	}
+
+	if strings.HasPrefix(name, "vlan") {
+		if vid, err := strconv.ParseUint(strings.TrimPrefix(name, "vlan"), 10, 16); err == nil {
+			return uint16(vid), true
+		}
+	}
+
+	return 0, false
+}
+
+// parseBondParameter parses `bond=` cmdline arguments, returning the
+// resulting bond LinkSpecs along with a slave interface name -> bond name
+// mapping so that the caller can mark slave links as enslaved.
+func parseBondParameter(cmdline *procfs.Cmdline) (map[string]string, []network.LinkSpecSpec, error) {
+	slaveOf := map[string]string{}
+
+	var links []network.LinkSpecSpec
+
+	for _, value := range cmdlineValues(cmdline, "bond") {
+		fields := strings.Split(value, ":")
+
+		name := fields[0]
+		if name == "" {
+			continue
+		}
+
+		bondMaster := network.BondMasterSpec{
+			Mode: nethelpers.BondModeRoundrobin,
+		}
+
+		if len(fields) > 1 && fields[1] != "" {
+			for _, slave := range strings.Split(fields[1], ",") {
+				slaveOf[slave] = name
+			}
+		}
+
+		if len(fields) > 2 && fields[2] != "" {
+			applyBondOptions(&bondMaster, fields[2])
+		}
+
+		link := network.LinkSpecSpec{
+			Name:       name,
+			Up:         true,
+			Logical:    true,
+			Kind:       network.LinkKindBond,
+			Type:       nethelpers.LinkEther,
+			BondMaster: bondMaster,
+		}
+
+		if len(fields) > 3 && fields[3] != "" {
+			if mtu, err := strconv.ParseUint(fields[3], 10, 32); err == nil {
+				link.MTU = uint32(mtu)
+			}
+		}
+
+		links = append(links, link)
+	}
+
+	return slaveOf, links, nil
+}
+
+// applyBondOptions parses a comma-separated list of `option=value` bond
+// options, as found in the third field of a `bond=` cmdline argument.
+func applyBondOptions(spec *network.BondMasterSpec, options string) {
+	for _, option := range strings.Split(options, ",") {
+		key, value, ok := strings.Cut(option, "=")
+		if !ok {
+			continue
+		}
+
+		switch key {
+		case "mode":
+			if mode, found := nethelpers.BondModeByName(value); found {
+				spec.Mode = mode
+			}
+		case "miimon":
+			if miimon, err := strconv.ParseUint(value, 10, 32); err == nil {
+				spec.MIIMon = uint32(miimon)
+			}
+		case "xmit_hash_policy":
+			if policy, found := nethelpers.XmitHashPolicyByName(value); found {
+				spec.XmitHashPolicy = policy
+			}
+		case "lacp_rate":
+			if rate, found := nethelpers.LACPRateByName(value); found {
+				spec.LACPRate = rate
+			}
+		}
+	}
+}
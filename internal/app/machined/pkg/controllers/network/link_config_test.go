@@ -118,25 +118,116 @@ func (suite *LinkConfigSuite) TestLoopback() {
 }
 
 func (suite *LinkConfigSuite) TestCmdline() {
-	suite.Require().NoError(suite.runtime.RegisterController(&netctrl.LinkConfigController{
-		Cmdline: procfs.NewCmdline("ip=172.20.0.2::172.20.0.1:255.255.255.0::eth1:::::"),
-	}))
+	for _, tt := range []struct {
+		name        string
+		cmdline     string
+		expectedIDs []string
+		check       func(name string, spec *network.LinkSpecSpec)
+	}{
+		{
+			name:        "single static ip=",
+			cmdline:     "ip=172.20.0.2::172.20.0.1:255.255.255.0::eth1:::::",
+			expectedIDs: []string{"cmdline/eth1"},
+			check: func(name string, spec *network.LinkSpecSpec) {
+				suite.Assert().Equal("eth1", spec.Name)
+				suite.Assert().True(spec.Up)
+				suite.Assert().False(spec.Logical)
+			},
+		},
+		{
+			name:        "two ip= entries",
+			cmdline:     "ip=172.20.0.2::172.20.0.1:255.255.255.0::eth1::::: ip=172.20.0.3::172.20.0.1:255.255.255.0::eth2:::::",
+			expectedIDs: []string{"cmdline/eth1", "cmdline/eth2"},
+			check: func(name string, spec *network.LinkSpecSpec) {
+				suite.Assert().Equal(name, spec.Name)
+				suite.Assert().True(spec.Up)
+				suite.Assert().False(spec.Logical)
+			},
+		},
+		{
+			name:        "dhcp short form",
+			cmdline:     "ip=eth3:dhcp",
+			expectedIDs: []string{"cmdline/eth3"},
+			check: func(name string, spec *network.LinkSpecSpec) {
+				suite.Assert().Equal("eth3", spec.Name)
+				suite.Assert().True(spec.Up)
+				suite.Assert().False(spec.Logical)
+				suite.Assert().True(spec.DHCP4)
+				suite.Assert().False(spec.DHCP6)
+			},
+		},
+		{
+			name:        "dhcp6 short form",
+			cmdline:     "ip=eth7:dhcp6",
+			expectedIDs: []string{"cmdline/eth7"},
+			check: func(name string, spec *network.LinkSpecSpec) {
+				suite.Assert().Equal("eth7", spec.Name)
+				suite.Assert().True(spec.Up)
+				suite.Assert().False(spec.DHCP4)
+				suite.Assert().True(spec.DHCP6)
+			},
+		},
+		{
+			name:        "bond and vlan",
+			cmdline:     "bond=bond0:eth4,eth5:mode=802.3ad,miimon=100:1400 vlan=bond0.100:bond0",
+			expectedIDs: []string{"cmdline/bond0", "cmdline/bond0.100", "cmdline/eth4", "cmdline/eth5"},
+			check: func(name string, spec *network.LinkSpecSpec) {
+				switch name {
+				case "bond0":
+					suite.Assert().True(spec.Up)
+					suite.Assert().True(spec.Logical)
+					suite.Assert().Equal(network.LinkKindBond, spec.Kind)
+					suite.Assert().Equal(nethelpers.BondMode8023AD, spec.BondMaster.Mode)
+					suite.Assert().EqualValues(100, spec.BondMaster.MIIMon)
+					suite.Assert().EqualValues(1400, spec.MTU)
+				case "bond0.100":
+					suite.Assert().True(spec.Up)
+					suite.Assert().True(spec.Logical)
+					suite.Assert().Equal(network.LinkKindVLAN, spec.Kind)
+					suite.Assert().Equal("bond0", spec.ParentName)
+					suite.Assert().EqualValues(100, spec.VLAN.VID)
+				case "eth4", "eth5":
+					suite.Assert().False(spec.Up)
+					suite.Assert().Equal("bond0", spec.MasterName)
+				}
+			},
+		},
+		{
+			name:        "bootdev hints the primary interface",
+			cmdline:     "ip=eth6:dhcp bootdev=eth6",
+			expectedIDs: []string{"cmdline/eth6"},
+			check: func(name string, spec *network.LinkSpecSpec) {
+				suite.Assert().Equal("eth6", spec.Name)
+				suite.Assert().True(spec.Primary)
+			},
+		},
+	} {
+		tt := tt
 
-	suite.startRuntime()
+		suite.Run(tt.name, func() {
+			suite.SetupTest()
 
-	suite.Assert().NoError(retry.Constant(3*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
-		func() error {
-			return suite.assertLinks([]string{
-				"cmdline/eth1",
-			}, func(r *network.LinkSpec) error {
-				suite.Assert().Equal("eth1", r.Status().Name)
-				suite.Assert().True(r.Status().Up)
-				suite.Assert().False(r.Status().Logical)
-				suite.Assert().Equal(network.ConfigCmdline, r.Status().ConfigLayer)
+			suite.Require().NoError(suite.runtime.RegisterController(&netctrl.LinkConfigController{
+				Cmdline: procfs.NewCmdline(tt.cmdline),
+			}))
 
-				return nil
-			})
-		}))
+			suite.startRuntime()
+
+			suite.Assert().NoError(retry.Constant(3*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+				func() error {
+					return suite.assertLinks(tt.expectedIDs, func(r *network.LinkSpec) error {
+						suite.Assert().Equal(network.ConfigCmdline, r.Status().ConfigLayer)
+
+						tt.check(r.Status().Name, r.Status())
+
+						return nil
+					})
+				}))
+
+			suite.ctxCancel()
+			suite.wg.Wait()
+		})
+	}
 }
 
 func (suite *LinkConfigSuite) TestMachineConfiguration() {
@@ -187,6 +278,21 @@ func (suite *LinkConfigSuite) TestMachineConfiguration() {
 							BondMode:       "balance-xor",
 						},
 					},
+					{
+						DeviceInterface: "eth4",
+					},
+					{
+						DeviceInterface: "eth5",
+					},
+					{
+						DeviceInterface: "bond1",
+						DeviceBond: &v1alpha1.Bond{
+							BondInterfaces:     []string{"eth4", "eth5"},
+							BondMode:           "802.3ad",
+							BondLACPRate:       "fast",
+							BondXmitHashPolicy: "layer2+3",
+						},
+					},
 					{
 						DeviceInterface: "dummy0",
 						DeviceDummy:     true,
@@ -194,11 +300,13 @@ func (suite *LinkConfigSuite) TestMachineConfiguration() {
 					{
 						DeviceInterface: "wireguard0",
 						DeviceWireguardConfig: &v1alpha1.DeviceWireguardConfig{
-							WireguardPrivateKey: "ABC",
+							WireguardPrivateKey: "AQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQE=",
 							WireguardPeers: []*v1alpha1.DeviceWireguardPeer{
 								{
-									WireguardPublicKey: "DEF",
-									WireguardEndpoint:  "10.0.0.1:3000",
+									WireguardPublicKey:                   "AgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgI=",
+									WireguardPresharedKey:                "AwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwM=",
+									WireguardEndpoint:                    "10.0.0.1:3000",
+									WireguardPersistentKeepaliveInterval: v1alpha1.Duration(30 * time.Second),
 									WireguardAllowedIPs: []string{
 										"10.2.3.0/24",
 										"10.2.4.0/24",
@@ -231,6 +339,9 @@ func (suite *LinkConfigSuite) TestMachineConfiguration() {
 				"configuration/eth2",
 				"configuration/eth3",
 				"configuration/bond0",
+				"configuration/eth4",
+				"configuration/eth5",
+				"configuration/bond1",
 				"configuration/dummy0",
 				"configuration/wireguard0",
 			}, func(r *network.LinkSpec) error {
@@ -257,6 +368,10 @@ func (suite *LinkConfigSuite) TestMachineConfiguration() {
 					suite.Assert().False(r.Status().Up)
 					suite.Assert().False(r.Status().Logical)
 					suite.Assert().Equal("bond0", r.Status().MasterName)
+				case "eth4", "eth5":
+					suite.Assert().False(r.Status().Up)
+					suite.Assert().False(r.Status().Logical)
+					suite.Assert().Equal("bond1", r.Status().MasterName)
 				case "bond0":
 					suite.Assert().True(r.Status().Up)
 					suite.Assert().True(r.Status().Logical)
@@ -264,17 +379,27 @@ func (suite *LinkConfigSuite) TestMachineConfiguration() {
 					suite.Assert().Equal(network.LinkKindBond, r.Status().Kind)
 					suite.Assert().Equal(nethelpers.BondModeXOR, r.Status().BondMaster.Mode)
 					suite.Assert().True(r.Status().BondMaster.UseCarrier)
+				case "bond1":
+					suite.Assert().True(r.Status().Up)
+					suite.Assert().True(r.Status().Logical)
+					suite.Assert().Equal(nethelpers.LinkEther, r.Status().Type)
+					suite.Assert().Equal(network.LinkKindBond, r.Status().Kind)
+					suite.Assert().Equal(nethelpers.BondMode8023AD, r.Status().BondMaster.Mode)
+					suite.Assert().Equal(nethelpers.LACPRateFast, r.Status().BondMaster.LACPRate)
+					suite.Assert().Equal(nethelpers.XmitHashPolicyLayer23, r.Status().BondMaster.XmitHashPolicy)
 				case "wireguard0":
 					suite.Assert().True(r.Status().Up)
 					suite.Assert().True(r.Status().Logical)
 					suite.Assert().Equal(nethelpers.LinkNone, r.Status().Type)
 					suite.Assert().Equal(network.LinkKindWireguard, r.Status().Kind)
 					suite.Assert().Equal(network.WireguardSpec{
-						PrivateKey: "ABC",
+						PrivateKey: "AQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQE=",
 						Peers: []network.WireguardPeer{
 							{
-								PublicKey: "DEF",
-								Endpoint:  "10.0.0.1:3000",
+								PublicKey:                   "AgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgI=",
+								PresharedKey:                "AwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwM=",
+								Endpoint:                    "10.0.0.1:3000",
+								PersistentKeepaliveInterval: 30 * time.Second,
 								AllowedIPs: []netaddr.IPPrefix{
 									netaddr.MustParseIPPrefix("10.2.3.0/24"),
 									netaddr.MustParseIPPrefix("10.2.4.0/24"),
@@ -289,6 +414,97 @@ func (suite *LinkConfigSuite) TestMachineConfiguration() {
 		}))
 }
 
+func (suite *LinkConfigSuite) TestBridgeMACVlanVeth() {
+	suite.Require().NoError(suite.runtime.RegisterController(&netctrl.LinkConfigController{}))
+
+	suite.startRuntime()
+
+	cfg := config.NewMachineConfig(&v1alpha1.Config{
+		ConfigVersion: "v1alpha1",
+		MachineConfig: &v1alpha1.MachineConfig{
+			MachineNetwork: &v1alpha1.NetworkConfig{
+				NetworkInterfaces: []*v1alpha1.Device{
+					{
+						DeviceInterface: "eth0",
+					},
+					{
+						DeviceInterface: "eth1",
+					},
+					{
+						DeviceInterface: "br0",
+						DeviceBridge: &v1alpha1.DeviceBridge{
+							BridgeInterfaces:    []string{"eth0", "eth1"},
+							BridgeVLANFiltering: true,
+							BridgeSTP: &v1alpha1.STP{
+								STPEnabled: true,
+							},
+						},
+					},
+					{
+						DeviceInterface: "macvlan0",
+						DeviceMACVlan: &v1alpha1.DeviceMACVlan{
+							MACVlanParent: "eth0",
+							MACVlanMode:   "bridge",
+						},
+					},
+					{
+						DeviceInterface: "veth0",
+						DeviceVeth: &v1alpha1.DeviceVeth{
+							VethPeerName:      "veth1",
+							VethPeerNamespace: "ns1",
+						},
+					},
+				},
+			},
+		},
+	})
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, cfg))
+
+	suite.Assert().NoError(retry.Constant(3*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+		func() error {
+			return suite.assertLinks([]string{
+				"configuration/eth0",
+				"configuration/eth1",
+				"configuration/br0",
+				"configuration/macvlan0",
+				"configuration/veth0",
+			}, func(r *network.LinkSpec) error {
+				suite.Assert().Equal(network.ConfigMachineConfiguration, r.Status().ConfigLayer)
+
+				switch r.Status().Name {
+				case "eth0", "eth1":
+					suite.Assert().False(r.Status().Up)
+					suite.Assert().False(r.Status().Logical)
+					suite.Assert().Equal("br0", r.Status().MasterName)
+				case "br0":
+					suite.Assert().True(r.Status().Up)
+					suite.Assert().True(r.Status().Logical)
+					suite.Assert().Equal(nethelpers.LinkEther, r.Status().Type)
+					suite.Assert().Equal(network.LinkKindBridge, r.Status().Kind)
+					suite.Assert().True(r.Status().BridgeMaster.VLANFiltering)
+					suite.Assert().True(r.Status().BridgeMaster.STPEnabled)
+				case "macvlan0":
+					suite.Assert().True(r.Status().Up)
+					suite.Assert().True(r.Status().Logical)
+					suite.Assert().Equal(nethelpers.LinkEther, r.Status().Type)
+					suite.Assert().Equal(network.LinkKindMACVlan, r.Status().Kind)
+					suite.Assert().Equal("eth0", r.Status().ParentName)
+					suite.Assert().Equal(nethelpers.MACVlanModeBridge, r.Status().MACVlan.Mode)
+				case "veth0":
+					suite.Assert().True(r.Status().Up)
+					suite.Assert().True(r.Status().Logical)
+					suite.Assert().Equal(nethelpers.LinkEther, r.Status().Type)
+					suite.Assert().Equal(network.LinkKindVeth, r.Status().Kind)
+					suite.Assert().Equal("veth1", r.Status().Veth.PeerName)
+					suite.Assert().Equal("ns1", r.Status().Veth.PeerNamespace)
+				}
+
+				return nil
+			})
+		}))
+}
+
 func TestLinkConfigSuite(t *testing.T) {
 	suite.Run(t, new(LinkConfigSuite))
-}
\ No newline at end of file
+}
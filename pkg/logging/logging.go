@@ -0,0 +1,28 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package logging provides helpers to adapt Talos logging to the controller
+// runtime's expected *zap.Logger.
+package logging
+
+import (
+	"io"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// Wrap wraps an io.Writer (e.g. the standard log package's writer) into a
+// *zap.Logger suitable for use with the controller runtime.
+func Wrap(w io.Writer) *zap.Logger {
+	encoderConfig := zap.NewDevelopmentEncoderConfig()
+
+	core := zapcore.NewCore(
+		zapcore.NewConsoleEncoder(encoderConfig),
+		zapcore.AddSync(w),
+		zap.DebugLevel,
+	)
+
+	return zap.New(core)
+}
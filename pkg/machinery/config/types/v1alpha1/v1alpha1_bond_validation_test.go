@@ -0,0 +1,137 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package v1alpha1_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1"
+)
+
+func TestBondValidate(t *testing.T) {
+	for _, tt := range []struct {
+		name    string
+		bond    v1alpha1.Bond
+		wantErr bool
+	}{
+		{
+			name: "valid miimon monitoring",
+			bond: v1alpha1.Bond{
+				BondMode:   "802.3ad",
+				BondMIIMon: 100,
+			},
+			wantErr: false,
+		},
+		{
+			name: "valid arp monitoring",
+			bond: v1alpha1.Bond{
+				BondMode:        "active-backup",
+				BondArpInterval: 100,
+				BondArpIPTarget: []string{"10.0.0.1"},
+			},
+			wantErr: false,
+		},
+		{
+			name:    "missing mode",
+			bond:    v1alpha1.Bond{BondMIIMon: 100},
+			wantErr: true,
+		},
+		{
+			name: "miimon and arp monitoring are mutually exclusive",
+			bond: v1alpha1.Bond{
+				BondMode:        "active-backup",
+				BondMIIMon:      100,
+				BondArpInterval: 100,
+				BondArpIPTarget: []string{"10.0.0.1"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "arpInterval without arpIpTarget",
+			bond: v1alpha1.Bond{
+				BondMode:        "active-backup",
+				BondArpInterval: 100,
+			},
+			wantErr: true,
+		},
+		{
+			name:    "unrecognized mode",
+			bond:    v1alpha1.Bond{BondMode: "8023ad"},
+			wantErr: true,
+		},
+		{
+			name: "unrecognized xmitHashPolicy",
+			bond: v1alpha1.Bond{
+				BondMode:           "balance-xor",
+				BondXmitHashPolicy: "layer23",
+			},
+			wantErr: true,
+		},
+		{
+			name: "unrecognized lacpRate",
+			bond: v1alpha1.Bond{
+				BondMode:     "802.3ad",
+				BondLACPRate: "quick",
+			},
+			wantErr: true,
+		},
+		{
+			name: "unrecognized adSelect",
+			bond: v1alpha1.Bond{
+				BondMode:     "802.3ad",
+				BondADSelect: "bandwith",
+			},
+			wantErr: true,
+		},
+		{
+			name: "unrecognized arpValidate",
+			bond: v1alpha1.Bond{
+				BondMode:        "active-backup",
+				BondArpValidate: "everything",
+			},
+			wantErr: true,
+		},
+		{
+			name: "unrecognized primaryReselect",
+			bond: v1alpha1.Bond{
+				BondMode:            "active-backup",
+				BondPrimaryReselect: "sometimes",
+			},
+			wantErr: true,
+		},
+		{
+			name: "unrecognized failOverMac",
+			bond: v1alpha1.Bond{
+				BondMode:        "active-backup",
+				BondFailOverMac: "maybe",
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid xmitHashPolicy, lacpRate and adSelect",
+			bond: v1alpha1.Bond{
+				BondMode:           "802.3ad",
+				BondXmitHashPolicy: "layer2+3",
+				BondLACPRate:       "fast",
+				BondADSelect:       "bandwidth",
+			},
+			wantErr: false,
+		},
+	} {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.bond.Validate()
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package v1alpha1_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1"
+)
+
+func TestDeviceMACVlanValidate(t *testing.T) {
+	for _, tt := range []struct {
+		name    string
+		macvlan v1alpha1.DeviceMACVlan
+		wantErr bool
+	}{
+		{
+			name:    "mode omitted",
+			macvlan: v1alpha1.DeviceMACVlan{MACVlanParent: "eth0"},
+			wantErr: false,
+		},
+		{
+			name:    "valid mode",
+			macvlan: v1alpha1.DeviceMACVlan{MACVlanParent: "eth0", MACVlanMode: "bridge"},
+			wantErr: false,
+		},
+		{
+			name:    "unrecognized mode",
+			macvlan: v1alpha1.DeviceMACVlan{MACVlanParent: "eth0", MACVlanMode: "privvate"},
+			wantErr: true,
+		},
+	} {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.macvlan.Validate()
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
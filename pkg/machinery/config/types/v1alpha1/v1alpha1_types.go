@@ -0,0 +1,256 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package v1alpha1 contains definitions for the v1alpha1 machine configuration
+// document.
+package v1alpha1
+
+import "net/url"
+
+// Config defines the v1alpha1 machine configuration document.
+type Config struct {
+	//   description: |
+	//     Indicates the schema used to decode the contents.
+	ConfigVersion string `yaml:"version"`
+	//   description: |
+	//     Provides machine specific configuration options.
+	MachineConfig *MachineConfig `yaml:"machine"`
+	//   description: |
+	//     Provides cluster specific configuration options.
+	ClusterConfig *ClusterConfig `yaml:"cluster"`
+}
+
+// MachineConfig reperesents the machine-level configuration options.
+type MachineConfig struct {
+	//   description: |
+	//     Provides machine specific network configuration options.
+	MachineNetwork *NetworkConfig `yaml:"network,omitempty"`
+}
+
+// NetworkConfig represents the machine's networking config options.
+type NetworkConfig struct {
+	//   description: |
+	//     The desired network interfaces to be configured.
+	NetworkInterfaces []*Device `yaml:"interfaces,omitempty"`
+}
+
+// Device represents a network interface.
+type Device struct {
+	//   description: |
+	//     The interface name.
+	DeviceInterface string `yaml:"interface"`
+	//   description: |
+	//     Used to statically set the IP address for the interface.
+	DeviceCIDR string `yaml:"cidr,omitempty"`
+	//   description: |
+	//     Indicates if DHCP should be used to configure the interface.
+	DeviceDHCP bool `yaml:"dhcp,omitempty"`
+	//   description: |
+	//     Indicates if the interface should be ignored.
+	DeviceIgnore bool `yaml:"ignore,omitempty"`
+	//   description: |
+	//     Indicates if the interface is a dummy interface.
+	DeviceDummy bool `yaml:"dummy,omitempty"`
+	//   description: |
+	//     The VLANs to be configured on top of this interface.
+	DeviceVlans []*Vlan `yaml:"vlans,omitempty"`
+	//   description: |
+	//     The interface bond configuration.
+	DeviceBond *Bond `yaml:"bond,omitempty"`
+	//   description: |
+	//     The interface bridge configuration.
+	DeviceBridge *DeviceBridge `yaml:"bridge,omitempty"`
+	//   description: |
+	//     The interface MACVLAN configuration.
+	DeviceMACVlan *DeviceMACVlan `yaml:"macvlan,omitempty"`
+	//   description: |
+	//     The interface veth configuration.
+	DeviceVeth *DeviceVeth `yaml:"veth,omitempty"`
+	//   description: |
+	//     The interface's Wireguard configuration.
+	DeviceWireguardConfig *DeviceWireguardConfig `yaml:"wireguard,omitempty"`
+}
+
+// Vlan represents a VLAN.
+type Vlan struct {
+	//   description: |
+	//     The VLAN's ID.
+	VlanID uint16 `yaml:"vlanId"`
+	//   description: |
+	//     The VLAN's CIDR.
+	VlanCIDR string `yaml:"cidr,omitempty"`
+}
+
+// Bond contains the various options for configuring a bonded interface.
+type Bond struct {
+	//   description: |
+	//     The interfaces that make up the bond.
+	BondInterfaces []string `yaml:"interfaces"`
+	//   description: |
+	//     A bond policy. Defines how slave interfaces are used during network transmissions.
+	BondMode string `yaml:"mode"`
+	//   description: |
+	//     Whether to use MII link monitoring based on the carrier state of the slave, as reported by the driver, rather than polling its MII registers.
+	//     Defaults to true when not set.
+	BondUseCarrier *bool `yaml:"useCarrier,omitempty"`
+	//   description: |
+	//     The MII link monitoring frequency, in milliseconds. Determines how often the link state of each slave is inspected.
+	//     Mutually exclusive with `arpInterval`/`arpIpTarget`.
+	BondMIIMon uint32 `yaml:"miimon,omitempty"`
+	//   description: |
+	//     The delay, in milliseconds, before a slave is enabled after a link recovery is detected, expressed as a multiple of `miimon`.
+	BondUpDelay uint32 `yaml:"updelay,omitempty"`
+	//   description: |
+	//     The delay, in milliseconds, before a slave is disabled after a link failure is detected, expressed as a multiple of `miimon`.
+	BondDownDelay uint32 `yaml:"downdelay,omitempty"`
+	//   description: |
+	//     The transmit hash policy used to select a slave for outgoing traffic in `balance-xor`, `802.3ad`, and `balance-tlb` modes.
+	//     One of `layer2`, `layer2+3`, `layer3+4`, `encap2+3`, `encap3+4`.
+	BondXmitHashPolicy string `yaml:"xmitHashPolicy,omitempty"`
+	//   description: |
+	//     The rate at which LACPDUs are transmitted to/expected from the link partner.
+	//     Only used in 802.3ad mode. One of `slow` (every 30 seconds) or `fast` (every second).
+	BondLACPRate string `yaml:"lacpRate,omitempty"`
+	//   description: |
+	//     The 802.3ad aggregation selection logic used to pick which aggregator to use.
+	//     Only used in 802.3ad mode. One of `stable`, `bandwidth`, or `count`.
+	BondADSelect string `yaml:"adSelect,omitempty"`
+	//   description: |
+	//     The ARP link monitoring frequency, in milliseconds.
+	//     Mutually exclusive with `miimon`, and requires `arpIpTarget` to be set.
+	BondArpInterval uint32 `yaml:"arpInterval,omitempty"`
+	//   description: |
+	//     The IP addresses to use as ARP monitoring peers when `arpInterval` is set.
+	BondArpIPTarget []string `yaml:"arpIpTarget,omitempty"`
+	//   description: |
+	//     Specifies whether ARP probes and replies should be validated, and for which slaves.
+	//     One of `none`, `active`, `backup`, or `all`.
+	BondArpValidate string `yaml:"arpValidate,omitempty"`
+	//   description: |
+	//     Specifies the reselection policy for the primary slave once it comes back up.
+	//     One of `always`, `better`, or `failure`.
+	BondPrimaryReselect string `yaml:"primaryReselect,omitempty"`
+	//   description: |
+	//     Specifies whether the bond's MAC address should follow the currently active slave
+	//     as slaves are added, removed, or fail over. One of `none`, `active`, or `follow`.
+	BondFailOverMac string `yaml:"failOverMac,omitempty"`
+	//   description: |
+	//     The number of IGMP membership reports to issue after a failover event.
+	BondResendIGMP uint8 `yaml:"resendIgmp,omitempty"`
+	//   description: |
+	//     The number of peer notifications (gratuitous ARPs or unsolicited IPv6 neighbor advertisements) issued after a failover event.
+	BondNumPeerNotif uint8 `yaml:"numPeerNotif,omitempty"`
+	//   description: |
+	//     Whether to deliver LACPDUs and ARP/ND probes to all slaves (1) rather than just the active slave (0).
+	BondAllSlavesActive uint8 `yaml:"allSlavesActive,omitempty"`
+	//   description: |
+	//     The minimum number of links that must be active before the bond is considered up, used with `802.3ad` mode.
+	BondMinLinks uint32 `yaml:"minLinks,omitempty"`
+	//   description: |
+	//     The number of packets to transmit through a slave before moving to the next one, used with `balance-rr` mode.
+	BondPacketsPerSlave uint32 `yaml:"packetsPerSlave,omitempty"`
+}
+
+// DeviceBridge contains the various options for configuring a bridge interface.
+type DeviceBridge struct {
+	//   description: |
+	//     The interfaces that make up the bridge.
+	BridgeInterfaces []string `yaml:"interfaces"`
+	//   description: |
+	//     Whether to enable VLAN-aware bridge filtering.
+	BridgeVLANFiltering bool `yaml:"vlanFiltering,omitempty"`
+	//   description: |
+	//     The bridge STP settings.
+	BridgeSTP *STP `yaml:"stp,omitempty"`
+}
+
+// STP contains the options for configuring bridge STP.
+type STP struct {
+	//   description: |
+	//     Whether Spanning Tree Protocol (STP) is enabled.
+	STPEnabled bool `yaml:"enabled,omitempty"`
+	//   description: |
+	//     The bridge forwarding delay, in seconds.
+	STPForwardDelay uint32 `yaml:"forwardDelay,omitempty"`
+	//   description: |
+	//     The bridge hello time, in seconds.
+	STPHelloTime uint32 `yaml:"helloTime,omitempty"`
+	//   description: |
+	//     The bridge max message age, in seconds.
+	STPMaxAge uint32 `yaml:"maxAge,omitempty"`
+	//   description: |
+	//     The bridge priority.
+	STPPriority uint16 `yaml:"priority,omitempty"`
+}
+
+// DeviceMACVlan contains the various options for configuring a MACVLAN interface.
+type DeviceMACVlan struct {
+	//   description: |
+	//     The parent interface to create the MACVLAN on top of.
+	MACVlanParent string `yaml:"parent"`
+	//   description: |
+	//     The MACVLAN mode. One of `private`, `vepa`, `bridge`, `passthru`, `source`.
+	MACVlanMode string `yaml:"mode,omitempty"`
+}
+
+// DeviceVeth contains the various options for configuring a veth interface.
+type DeviceVeth struct {
+	//   description: |
+	//     The name of the veth peer.
+	VethPeerName string `yaml:"peerName"`
+	//   description: |
+	//     The network namespace to move the veth peer into.
+	VethPeerNamespace string `yaml:"peerNamespace,omitempty"`
+}
+
+// DeviceWireguardConfig contains settings for configuring Wireguard network interface.
+type DeviceWireguardConfig struct {
+	//   description: |
+	//     Specifies a private key configuration (base64 encoded).
+	WireguardPrivateKey string `yaml:"privateKey,omitempty"`
+	//   description: |
+	//     Specifies a device's listening port.
+	WireguardListenPort int `yaml:"listenPort,omitempty"`
+	//   description: |
+	//     Specifies a lists of peer configurations to apply to a device.
+	WireguardPeers []*DeviceWireguardPeer `yaml:"peers,omitempty"`
+}
+
+// DeviceWireguardPeer a WireGuard device peer configuration.
+type DeviceWireguardPeer struct {
+	//   description: |
+	//     Specifies the public key of this peer (base64 encoded).
+	WireguardPublicKey string `yaml:"publicKey,omitempty"`
+	//   description: |
+	//     Specifies the endpoint of this peer entry.
+	WireguardEndpoint string `yaml:"endpoint,omitempty"`
+	//   description: |
+	//     Specifies the pre-shared key for this peer (base64 encoded).
+	WireguardPresharedKey string `yaml:"presharedKey,omitempty"`
+	//   description: |
+	//     Specifies the persistent keepalive interval for this peer.
+	WireguardPersistentKeepaliveInterval Duration `yaml:"persistentKeepaliveInterval,omitempty"`
+	//   description: |
+	//     AllowedIPs specifies a list of allowed IP addresses in CIDR notation for this peer.
+	WireguardAllowedIPs []string `yaml:"allowedIPs,omitempty"`
+}
+
+// ClusterConfig represents the cluster-wide config values.
+type ClusterConfig struct {
+	//   description: |
+	//     Provides control plane specific configuration options.
+	ControlPlane *ControlPlaneConfig `yaml:"controlPlane"`
+}
+
+// ControlPlaneConfig represents the control plane configuration options.
+type ControlPlaneConfig struct {
+	//   description: |
+	//     Endpoint is the canonical controlplane endpoint.
+	Endpoint *Endpoint `yaml:"endpoint"`
+}
+
+// Endpoint wraps url.URL to provide YAML marshaling for a cluster endpoint.
+type Endpoint struct {
+	*url.URL
+}
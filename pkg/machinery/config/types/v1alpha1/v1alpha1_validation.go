@@ -0,0 +1,216 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package v1alpha1
+
+import (
+	"encoding/base64"
+	"fmt"
+
+	"github.com/talos-systems/talos/pkg/machinery/nethelpers"
+)
+
+// wireguardKeyLength is the decoded length, in bytes, of a Wireguard
+// Curve25519 key (private, public, or preshared).
+const wireguardKeyLength = 32
+
+// maxPersistentKeepalive is the largest keepalive interval Wireguard accepts;
+// it mirrors the protocol's 16-bit seconds field.
+const maxPersistentKeepalive = Duration(65535 * 1e9)
+
+// Validate checks the configuration for internal consistency, returning all
+// errors it finds rather than stopping at the first one.
+func (c *Config) Validate() error {
+	if c.MachineConfig == nil || c.MachineConfig.MachineNetwork == nil {
+		return nil
+	}
+
+	var errs []error
+
+	for _, device := range c.MachineConfig.MachineNetwork.NetworkInterfaces {
+		if err := device.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("interface %q: %w", device.DeviceInterface, err))
+		}
+	}
+
+	return combineErrors(errs)
+}
+
+// Validate checks a single network interface definition.
+func (d *Device) Validate() error {
+	var errs []error
+
+	if d.DeviceBond != nil {
+		if err := d.DeviceBond.Validate(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if d.DeviceWireguardConfig != nil {
+		if err := d.DeviceWireguardConfig.Validate(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if d.DeviceMACVlan != nil {
+		if err := d.DeviceMACVlan.Validate(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return combineErrors(errs)
+}
+
+// Validate checks the Wireguard device configuration, including every peer.
+func (wc *DeviceWireguardConfig) Validate() error {
+	var errs []error
+
+	if wc.WireguardPrivateKey != "" {
+		if err := validateWireguardKey(wc.WireguardPrivateKey); err != nil {
+			errs = append(errs, fmt.Errorf("private key: %w", err))
+		}
+	}
+
+	for _, peer := range wc.WireguardPeers {
+		if err := peer.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("peer %q: %w", peer.WireguardPublicKey, err))
+		}
+	}
+
+	return combineErrors(errs)
+}
+
+// Validate checks a single Wireguard peer configuration.
+func (p *DeviceWireguardPeer) Validate() error {
+	var errs []error
+
+	if p.WireguardPublicKey != "" {
+		if err := validateWireguardKey(p.WireguardPublicKey); err != nil {
+			errs = append(errs, fmt.Errorf("public key: %w", err))
+		}
+	}
+
+	if p.WireguardPresharedKey != "" {
+		if err := validateWireguardKey(p.WireguardPresharedKey); err != nil {
+			errs = append(errs, fmt.Errorf("preshared key: %w", err))
+		}
+	}
+
+	if p.WireguardPersistentKeepaliveInterval < 0 {
+		errs = append(errs, fmt.Errorf("persistent keepalive interval must not be negative, got %s", p.WireguardPersistentKeepaliveInterval))
+	} else if p.WireguardPersistentKeepaliveInterval > maxPersistentKeepalive {
+		errs = append(errs, fmt.Errorf("persistent keepalive interval must not exceed %s, got %s", maxPersistentKeepalive, p.WireguardPersistentKeepaliveInterval))
+	}
+
+	return combineErrors(errs)
+}
+
+// Validate checks the bond master configuration.
+func (b *Bond) Validate() error {
+	var errs []error
+
+	if b.BondMode == "" {
+		errs = append(errs, fmt.Errorf("mode is required"))
+	} else if _, ok := nethelpers.BondModeByName(b.BondMode); !ok {
+		errs = append(errs, fmt.Errorf("mode %q is not a recognized bond mode", b.BondMode))
+	}
+
+	if b.BondXmitHashPolicy != "" {
+		if _, ok := nethelpers.XmitHashPolicyByName(b.BondXmitHashPolicy); !ok {
+			errs = append(errs, fmt.Errorf("xmitHashPolicy %q is not a recognized transmit hash policy", b.BondXmitHashPolicy))
+		}
+	}
+
+	if b.BondLACPRate != "" {
+		if _, ok := nethelpers.LACPRateByName(b.BondLACPRate); !ok {
+			errs = append(errs, fmt.Errorf("lacpRate %q is not a recognized LACP rate", b.BondLACPRate))
+		}
+	}
+
+	if b.BondADSelect != "" {
+		if _, ok := nethelpers.ADSelectByName(b.BondADSelect); !ok {
+			errs = append(errs, fmt.Errorf("adSelect %q is not a recognized AD select policy", b.BondADSelect))
+		}
+	}
+
+	if b.BondArpValidate != "" {
+		if _, ok := nethelpers.ARPValidateByName(b.BondArpValidate); !ok {
+			errs = append(errs, fmt.Errorf("arpValidate %q is not a recognized ARP validate mode", b.BondArpValidate))
+		}
+	}
+
+	if b.BondPrimaryReselect != "" {
+		if _, ok := nethelpers.PrimaryReselectByName(b.BondPrimaryReselect); !ok {
+			errs = append(errs, fmt.Errorf("primaryReselect %q is not a recognized primary reselect policy", b.BondPrimaryReselect))
+		}
+	}
+
+	if b.BondFailOverMac != "" {
+		if _, ok := nethelpers.FailOverMACByName(b.BondFailOverMac); !ok {
+			errs = append(errs, fmt.Errorf("failOverMac %q is not a recognized fail-over MAC policy", b.BondFailOverMac))
+		}
+	}
+
+	miiConfigured := b.BondMIIMon != 0 || b.BondUpDelay != 0 || b.BondDownDelay != 0
+	arpConfigured := b.BondArpInterval != 0 || len(b.BondArpIPTarget) > 0
+
+	if miiConfigured && arpConfigured {
+		errs = append(errs, fmt.Errorf("miimon and arp monitoring are mutually exclusive"))
+	}
+
+	if b.BondArpInterval != 0 && len(b.BondArpIPTarget) == 0 {
+		errs = append(errs, fmt.Errorf("arpIpTarget is required when arpInterval is set"))
+	}
+
+	return combineErrors(errs)
+}
+
+// Validate checks the MACVLAN configuration.
+func (mv *DeviceMACVlan) Validate() error {
+	if mv.MACVlanMode == "" {
+		return nil
+	}
+
+	if _, ok := nethelpers.MACVlanModeByName(mv.MACVlanMode); !ok {
+		return fmt.Errorf("mode %q is not a recognized MACVLAN mode", mv.MACVlanMode)
+	}
+
+	return nil
+}
+
+// validateWireguardKey checks that key decodes as standard base64 to exactly
+// wireguardKeyLength bytes, matching the Curve25519 key size Wireguard uses
+// for private, public, and preshared keys alike.
+func validateWireguardKey(key string) error {
+	decoded, err := base64.StdEncoding.DecodeString(key)
+	if err != nil {
+		return fmt.Errorf("invalid base64 encoding: %w", err)
+	}
+
+	if len(decoded) != wireguardKeyLength {
+		return fmt.Errorf("expected a %d byte key once base64 decoded, got %d", wireguardKeyLength, len(decoded))
+	}
+
+	return nil
+}
+
+func combineErrors(errs []error) error {
+	if len(errs) == 0 {
+		return nil
+	}
+
+	var combined error
+
+	for _, err := range errs {
+		if combined == nil {
+			combined = err
+
+			continue
+		}
+
+		combined = fmt.Errorf("%w; %w", combined, err)
+	}
+
+	return combined
+}
@@ -0,0 +1,75 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package v1alpha1_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1"
+)
+
+func TestDeviceWireguardPeerValidate(t *testing.T) {
+	for _, tt := range []struct {
+		name    string
+		peer    v1alpha1.DeviceWireguardPeer
+		wantErr bool
+	}{
+		{
+			name: "valid key and keepalive",
+			peer: v1alpha1.DeviceWireguardPeer{
+				WireguardPublicKey:                   "AgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgI=",
+				WireguardPresharedKey:                "AwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwMDAwM=",
+				WireguardPersistentKeepaliveInterval: v1alpha1.Duration(30 * 1e9),
+			},
+			wantErr: false,
+		},
+		{
+			name: "preshared key is not valid base64",
+			peer: v1alpha1.DeviceWireguardPeer{
+				WireguardPublicKey:    "AgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgI=",
+				WireguardPresharedKey: "not-base64!!",
+			},
+			wantErr: true,
+		},
+		{
+			name: "preshared key decodes to the wrong length",
+			peer: v1alpha1.DeviceWireguardPeer{
+				WireguardPublicKey:    "AgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgI=",
+				WireguardPresharedKey: "QUJD",
+			},
+			wantErr: true,
+		},
+		{
+			name: "negative keepalive interval",
+			peer: v1alpha1.DeviceWireguardPeer{
+				WireguardPublicKey:                   "AgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgI=",
+				WireguardPersistentKeepaliveInterval: v1alpha1.Duration(-1),
+			},
+			wantErr: true,
+		},
+		{
+			name: "keepalive interval exceeds the protocol maximum",
+			peer: v1alpha1.DeviceWireguardPeer{
+				WireguardPublicKey:                   "AgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgI=",
+				WireguardPersistentKeepaliveInterval: v1alpha1.Duration(70000 * 1e9),
+			},
+			wantErr: true,
+		},
+	} {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.peer.Validate()
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,283 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package nethelpers
+
+// BondMode is a bond operating mode.
+type BondMode int
+
+// BondMode constants.
+const (
+	BondModeRoundrobin BondMode = iota
+	BondModeActiveBackup
+	BondModeXOR
+	BondModeBroadcast
+	BondMode8023AD
+	BondModeTLB
+	BondModeALB
+)
+
+// String implements fmt.Stringer.
+func (m BondMode) String() string {
+	switch m {
+	case BondModeRoundrobin:
+		return "balance-rr"
+	case BondModeActiveBackup:
+		return "active-backup"
+	case BondModeXOR:
+		return "balance-xor"
+	case BondModeBroadcast:
+		return "broadcast"
+	case BondMode8023AD:
+		return "802.3ad"
+	case BondModeTLB:
+		return "balance-tlb"
+	case BondModeALB:
+		return "balance-alb"
+	default:
+		return "unknown"
+	}
+}
+
+// BondModeByName parses a bond mode name into a BondMode.
+func BondModeByName(name string) (BondMode, bool) {
+	for _, mode := range []BondMode{
+		BondModeRoundrobin,
+		BondModeActiveBackup,
+		BondModeXOR,
+		BondModeBroadcast,
+		BondMode8023AD,
+		BondModeTLB,
+		BondModeALB,
+	} {
+		if mode.String() == name {
+			return mode, true
+		}
+	}
+
+	return 0, false
+}
+
+// XmitHashPolicy is a bond xmit hash policy, used to select a slave for
+// outgoing traffic in the balance-xor, 802.3ad and tlb modes.
+type XmitHashPolicy int
+
+// XmitHashPolicy constants.
+const (
+	XmitHashPolicyLayer2 XmitHashPolicy = iota
+	XmitHashPolicyLayer23
+	XmitHashPolicyLayer34
+	XmitHashPolicyEncap23
+	XmitHashPolicyEncap34
+)
+
+// String implements fmt.Stringer.
+func (p XmitHashPolicy) String() string {
+	switch p {
+	case XmitHashPolicyLayer2:
+		return "layer2"
+	case XmitHashPolicyLayer23:
+		return "layer2+3"
+	case XmitHashPolicyLayer34:
+		return "layer3+4"
+	case XmitHashPolicyEncap23:
+		return "encap2+3"
+	case XmitHashPolicyEncap34:
+		return "encap3+4"
+	default:
+		return "unknown"
+	}
+}
+
+// XmitHashPolicyByName parses a xmit hash policy name into a XmitHashPolicy.
+func XmitHashPolicyByName(name string) (XmitHashPolicy, bool) {
+	for _, policy := range []XmitHashPolicy{
+		XmitHashPolicyLayer2,
+		XmitHashPolicyLayer23,
+		XmitHashPolicyLayer34,
+		XmitHashPolicyEncap23,
+		XmitHashPolicyEncap34,
+	} {
+		if policy.String() == name {
+			return policy, true
+		}
+	}
+
+	return 0, false
+}
+
+// LACPRate defines the rate at which link partners exchange LACPDU frames in 802.3ad mode.
+type LACPRate int
+
+// LACPRate constants.
+const (
+	LACPRateSlow LACPRate = iota
+	LACPRateFast
+)
+
+// String implements fmt.Stringer.
+func (r LACPRate) String() string {
+	switch r {
+	case LACPRateSlow:
+		return "slow"
+	case LACPRateFast:
+		return "fast"
+	default:
+		return "unknown"
+	}
+}
+
+// LACPRateByName parses a LACP rate name into a LACPRate.
+func LACPRateByName(name string) (LACPRate, bool) {
+	for _, rate := range []LACPRate{LACPRateSlow, LACPRateFast} {
+		if rate.String() == name {
+			return rate, true
+		}
+	}
+
+	return 0, false
+}
+
+// ADSelect defines the 802.3ad aggregation selection logic.
+type ADSelect int
+
+// ADSelect constants.
+const (
+	ADSelectStable ADSelect = iota
+	ADSelectBandwidth
+	ADSelectCount
+)
+
+// String implements fmt.Stringer.
+func (s ADSelect) String() string {
+	switch s {
+	case ADSelectStable:
+		return "stable"
+	case ADSelectBandwidth:
+		return "bandwidth"
+	case ADSelectCount:
+		return "count"
+	default:
+		return "unknown"
+	}
+}
+
+// ADSelectByName parses an ad_select name into an ADSelect.
+func ADSelectByName(name string) (ADSelect, bool) {
+	for _, sel := range []ADSelect{ADSelectStable, ADSelectBandwidth, ADSelectCount} {
+		if sel.String() == name {
+			return sel, true
+		}
+	}
+
+	return 0, false
+}
+
+// ARPValidate defines which ARP probes get validated in ARP monitoring mode.
+type ARPValidate int
+
+// ARPValidate constants.
+const (
+	ARPValidateNone ARPValidate = iota
+	ARPValidateActive
+	ARPValidateBackup
+	ARPValidateAll
+)
+
+// String implements fmt.Stringer.
+func (v ARPValidate) String() string {
+	switch v {
+	case ARPValidateNone:
+		return "none"
+	case ARPValidateActive:
+		return "active"
+	case ARPValidateBackup:
+		return "backup"
+	case ARPValidateAll:
+		return "all"
+	default:
+		return "unknown"
+	}
+}
+
+// ARPValidateByName parses an arp_validate name into an ARPValidate.
+func ARPValidateByName(name string) (ARPValidate, bool) {
+	for _, v := range []ARPValidate{ARPValidateNone, ARPValidateActive, ARPValidateBackup, ARPValidateAll} {
+		if v.String() == name {
+			return v, true
+		}
+	}
+
+	return 0, false
+}
+
+// PrimaryReselect defines when the primary slave is reselected in active-backup mode.
+type PrimaryReselect int
+
+// PrimaryReselect constants.
+const (
+	PrimaryReselectAlways PrimaryReselect = iota
+	PrimaryReselectBetter
+	PrimaryReselectFailure
+)
+
+// String implements fmt.Stringer.
+func (r PrimaryReselect) String() string {
+	switch r {
+	case PrimaryReselectAlways:
+		return "always"
+	case PrimaryReselectBetter:
+		return "better"
+	case PrimaryReselectFailure:
+		return "failure"
+	default:
+		return "unknown"
+	}
+}
+
+// PrimaryReselectByName parses a primary_reselect name into a PrimaryReselect.
+func PrimaryReselectByName(name string) (PrimaryReselect, bool) {
+	for _, r := range []PrimaryReselect{PrimaryReselectAlways, PrimaryReselectBetter, PrimaryReselectFailure} {
+		if r.String() == name {
+			return r, true
+		}
+	}
+
+	return 0, false
+}
+
+// FailOverMAC defines how the bond's MAC address is managed in active-backup mode.
+type FailOverMAC int
+
+// FailOverMAC constants.
+const (
+	FailOverMACNone FailOverMAC = iota
+	FailOverMACActive
+	FailOverMACFollow
+)
+
+// String implements fmt.Stringer.
+func (m FailOverMAC) String() string {
+	switch m {
+	case FailOverMACNone:
+		return "none"
+	case FailOverMACActive:
+		return "active"
+	case FailOverMACFollow:
+		return "follow"
+	default:
+		return "unknown"
+	}
+}
+
+// FailOverMACByName parses a fail_over_mac name into a FailOverMAC.
+func FailOverMACByName(name string) (FailOverMAC, bool) {
+	for _, m := range []FailOverMAC{FailOverMACNone, FailOverMACActive, FailOverMACFollow} {
+		if m.String() == name {
+			return m, true
+		}
+	}
+
+	return 0, false
+}
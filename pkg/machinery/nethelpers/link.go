@@ -0,0 +1,29 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package nethelpers
+
+// LinkType is a backing `rtnetlink` link type.
+type LinkType int
+
+// LinkType constants.
+const (
+	LinkEther LinkType = iota
+	LinkNone
+	LinkLoopback
+)
+
+// String implements fmt.Stringer.
+func (t LinkType) String() string {
+	switch t {
+	case LinkEther:
+		return "ether"
+	case LinkNone:
+		return "none"
+	case LinkLoopback:
+		return "loopback"
+	default:
+		return "unknown"
+	}
+}
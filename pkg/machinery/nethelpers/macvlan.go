@@ -0,0 +1,52 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package nethelpers
+
+// MACVlanMode is a MACVLAN operating mode.
+type MACVlanMode int
+
+// MACVlanMode constants.
+const (
+	MACVlanModePrivate MACVlanMode = iota
+	MACVlanModeVEPA
+	MACVlanModeBridge
+	MACVlanModePassthru
+	MACVlanModeSource
+)
+
+// String implements fmt.Stringer.
+func (m MACVlanMode) String() string {
+	switch m {
+	case MACVlanModePrivate:
+		return "private"
+	case MACVlanModeVEPA:
+		return "vepa"
+	case MACVlanModeBridge:
+		return "bridge"
+	case MACVlanModePassthru:
+		return "passthru"
+	case MACVlanModeSource:
+		return "source"
+	default:
+		return "unknown"
+	}
+}
+
+// MACVlanModeByName parses a MACVLAN mode name into a MACVlanMode.
+func MACVlanModeByName(name string) (MACVlanMode, bool) {
+	for _, mode := range []MACVlanMode{
+		MACVlanModePrivate,
+		MACVlanModeVEPA,
+		MACVlanModeBridge,
+		MACVlanModePassthru,
+		MACVlanModeSource,
+	} {
+		if mode.String() == name {
+			return mode, true
+		}
+	}
+
+	return 0, false
+}
@@ -0,0 +1,26 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package nethelpers
+
+// VLANProtocol is a VLAN protocol.
+type VLANProtocol int
+
+// VLANProtocol constants.
+const (
+	VLANProtocol8021Q VLANProtocol = iota
+	VLANProtocol8021AD
+)
+
+// String implements fmt.Stringer.
+func (p VLANProtocol) String() string {
+	switch p {
+	case VLANProtocol8021Q:
+		return "802.1q"
+	case VLANProtocol8021AD:
+		return "802.1ad"
+	default:
+		return "unknown"
+	}
+}
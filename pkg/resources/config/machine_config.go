@@ -0,0 +1,72 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package config
+
+import (
+	"fmt"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+
+	"github.com/talos-systems/talos/pkg/machinery/config/types/v1alpha1"
+)
+
+// NamespaceName is the namespace for machine configuration resources.
+const NamespaceName resource.Namespace = "config"
+
+// MachineConfigType is the type of MachineConfig resource.
+const MachineConfigType = resource.Type("MachineConfigs.config.talos.dev")
+
+// MachineConfigID is the singleton ID of the MachineConfig resource.
+const MachineConfigID = resource.ID("v1alpha1")
+
+// MachineConfig resource holds the machine configuration document.
+type MachineConfig struct {
+	md   resource.Metadata
+	spec v1alpha1.Config
+}
+
+// NewMachineConfig initializes a MachineConfig resource from a decoded
+// v1alpha1 configuration document.
+func NewMachineConfig(cfg *v1alpha1.Config) *MachineConfig {
+	r := &MachineConfig{
+		md: resource.NewMetadata(NamespaceName, MachineConfigType, MachineConfigID, resource.VersionUndefined),
+	}
+
+	if cfg != nil {
+		r.spec = *cfg
+	}
+
+	r.md.BumpVersion()
+
+	return r
+}
+
+// Metadata implements resource.Resource.
+func (r *MachineConfig) Metadata() *resource.Metadata {
+	return &r.md
+}
+
+// Spec implements resource.Resource.
+func (r *MachineConfig) Spec() interface{} {
+	return r.spec
+}
+
+// DeepCopy implements resource.Resource.
+func (r *MachineConfig) DeepCopy() resource.Resource {
+	return &MachineConfig{
+		md:   r.md,
+		spec: r.spec,
+	}
+}
+
+// String implements fmt.Stringer.
+func (r *MachineConfig) String() string {
+	return fmt.Sprintf("config.MachineConfig(%q)", r.md.ID())
+}
+
+// Config returns the underlying v1alpha1 configuration document.
+func (r *MachineConfig) Config() *v1alpha1.Config {
+	return &r.spec
+}
@@ -0,0 +1,29 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import "github.com/talos-systems/talos/pkg/machinery/nethelpers"
+
+// BondMasterSpec describes bond master link settings.
+type BondMasterSpec struct {
+	Mode            nethelpers.BondMode        `yaml:"mode"`
+	UseCarrier      bool                       `yaml:"useCarrier"`
+	MIIMon          uint32                     `yaml:"miimon,omitempty"`
+	UpDelay         uint32                     `yaml:"updelay,omitempty"`
+	DownDelay       uint32                     `yaml:"downdelay,omitempty"`
+	XmitHashPolicy  nethelpers.XmitHashPolicy  `yaml:"xmitHashPolicy,omitempty"`
+	LACPRate        nethelpers.LACPRate        `yaml:"lacpRate,omitempty"`
+	ADSelect        nethelpers.ADSelect        `yaml:"adSelect,omitempty"`
+	ArpInterval     uint32                     `yaml:"arpInterval,omitempty"`
+	ArpIPTarget     []string                   `yaml:"arpIpTarget,omitempty"`
+	ArpValidate     nethelpers.ARPValidate     `yaml:"arpValidate,omitempty"`
+	PrimaryReselect nethelpers.PrimaryReselect `yaml:"primaryReselect,omitempty"`
+	FailOverMac     nethelpers.FailOverMAC     `yaml:"failOverMac,omitempty"`
+	ResendIGMP      uint8                      `yaml:"resendIgmp,omitempty"`
+	NumPeerNotif    uint8                      `yaml:"numPeerNotif,omitempty"`
+	AllSlavesActive uint8                      `yaml:"allSlavesActive,omitempty"`
+	MinLinks        uint32                     `yaml:"minLinks,omitempty"`
+	PacketsPerSlave uint32                     `yaml:"packetsPerSlave,omitempty"`
+}
@@ -0,0 +1,15 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+// BridgeMasterSpec describes bridge master link settings.
+type BridgeMasterSpec struct {
+	STPEnabled    bool   `yaml:"stpEnabled,omitempty"`
+	ForwardDelay  uint32 `yaml:"forwardDelay,omitempty"`
+	HelloTime     uint32 `yaml:"helloTime,omitempty"`
+	MaxAge        uint32 `yaml:"maxAge,omitempty"`
+	Priority      uint16 `yaml:"priority,omitempty"`
+	VLANFiltering bool   `yaml:"vlanFiltering,omitempty"`
+}
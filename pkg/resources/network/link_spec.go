@@ -0,0 +1,131 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"fmt"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+
+	"github.com/talos-systems/talos/pkg/machinery/nethelpers"
+)
+
+// LinkSpecType is the type of LinkSpec resource.
+const LinkSpecType = resource.Type("LinkSpecs.net.talos.dev")
+
+// ConfigNamespaceName is the namespace for network configuration resources produced by controllers.
+const ConfigNamespaceName resource.Namespace = "network-config"
+
+// ConfigLayer describes which part of the system produced a given piece of network configuration.
+type ConfigLayer int
+
+// ConfigLayer values.
+const (
+	ConfigDefault ConfigLayer = iota
+	ConfigCmdline
+	ConfigMachineConfiguration
+	ConfigOperator
+)
+
+// String implements fmt.Stringer.
+func (layer ConfigLayer) String() string {
+	switch layer {
+	case ConfigDefault:
+		return "default"
+	case ConfigCmdline:
+		return "cmdline"
+	case ConfigMachineConfiguration:
+		return "configuration"
+	case ConfigOperator:
+		return "operator"
+	default:
+		return "unknown"
+	}
+}
+
+// LinkKind describes the kind of logical link to synthesize.
+type LinkKind string
+
+// LinkKind values.
+const (
+	LinkKindVLAN      LinkKind = "vlan"
+	LinkKindBond      LinkKind = "bond"
+	LinkKindDummy     LinkKind = "dummy"
+	LinkKindWireguard LinkKind = "wireguard"
+	LinkKindBridge    LinkKind = "bridge"
+	LinkKindMACVlan   LinkKind = "macvlan"
+	LinkKindVeth      LinkKind = "veth"
+)
+
+// LinkSpecSpec describes desired configuration for the network link.
+type LinkSpecSpec struct {
+	Name         string              `yaml:"name"`
+	Logical      bool                `yaml:"logical"`
+	Up           bool                `yaml:"up"`
+	MTU          uint32              `yaml:"mtu"`
+	Kind         LinkKind            `yaml:"kind,omitempty"`
+	Type         nethelpers.LinkType `yaml:"type"`
+	ParentName   string              `yaml:"parentName,omitempty"`
+	MasterName   string              `yaml:"masterName,omitempty"`
+	BondMaster   BondMasterSpec      `yaml:"bondMaster,omitempty"`
+	Wireguard    WireguardSpec       `yaml:"wireguard,omitempty"`
+	VLAN         VLANSpec            `yaml:"vlan,omitempty"`
+	BridgeMaster BridgeMasterSpec    `yaml:"bridgeMaster,omitempty"`
+	MACVlan      MACVlanSpec         `yaml:"macVlan,omitempty"`
+	Veth         VethSpec            `yaml:"veth,omitempty"`
+	Primary      bool                `yaml:"primary,omitempty"`
+	DHCP4        bool                `yaml:"dhcp4,omitempty"`
+	DHCP6        bool                `yaml:"dhcp6,omitempty"`
+	ConfigLayer  ConfigLayer         `yaml:"layer"`
+}
+
+// LinkSpec resource holds a desired network link (physical or logical) configuration.
+type LinkSpec struct {
+	md   resource.Metadata
+	spec LinkSpecSpec
+}
+
+// NewLinkSpec initializes a LinkSpec resource.
+func NewLinkSpec(namespace resource.Namespace, id resource.ID) *LinkSpec {
+	r := &LinkSpec{
+		md: resource.NewMetadata(namespace, LinkSpecType, id, resource.VersionUndefined),
+	}
+
+	r.md.BumpVersion()
+
+	return r
+}
+
+// Metadata implements resource.Resource.
+func (r *LinkSpec) Metadata() *resource.Metadata {
+	return &r.md
+}
+
+// Spec implements resource.Resource.
+func (r *LinkSpec) Spec() interface{} {
+	return r.spec
+}
+
+// DeepCopy implements resource.Resource.
+func (r *LinkSpec) DeepCopy() resource.Resource {
+	return &LinkSpec{
+		md:   r.md,
+		spec: r.spec,
+	}
+}
+
+// String implements fmt.Stringer.
+func (r *LinkSpec) String() string {
+	return fmt.Sprintf("network.LinkSpec(%q)", r.md.ID())
+}
+
+// Status returns the link spec payload.
+//
+// Despite the name, LinkSpec carries desired state rather than observed
+// status: the accessor is kept consistent with the rest of the network
+// resources so that controllers and tests can use a single idiom.
+func (r *LinkSpec) Status() *LinkSpecSpec {
+	return &r.spec
+}
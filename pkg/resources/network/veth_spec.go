@@ -0,0 +1,11 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+// VethSpec describes veth link settings.
+type VethSpec struct {
+	PeerName      string `yaml:"peerName"`
+	PeerNamespace string `yaml:"peerNamespace,omitempty"`
+}
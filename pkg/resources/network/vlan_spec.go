@@ -0,0 +1,13 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import "github.com/talos-systems/talos/pkg/machinery/nethelpers"
+
+// VLANSpec describes VLAN link settings.
+type VLANSpec struct {
+	VID      uint16                  `yaml:"vlanId"`
+	Protocol nethelpers.VLANProtocol `yaml:"vlanProtocol"`
+}
@@ -0,0 +1,28 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"time"
+
+	"inet.af/netaddr"
+)
+
+// WireguardSpec describes Wireguard link settings.
+type WireguardSpec struct {
+	PrivateKey   string          `yaml:"privateKey"`
+	ListenPort   int             `yaml:"listenPort"`
+	FirewallMark int             `yaml:"firewallMark"`
+	Peers        []WireguardPeer `yaml:"peers"`
+}
+
+// WireguardPeer describes a single Wireguard peer.
+type WireguardPeer struct {
+	PublicKey                   string             `yaml:"publicKey"`
+	PresharedKey                string             `yaml:"presharedKey,omitempty"`
+	Endpoint                    string             `yaml:"endpoint"`
+	PersistentKeepaliveInterval time.Duration      `yaml:"persistentKeepaliveInterval,omitempty"`
+	AllowedIPs                  []netaddr.IPPrefix `yaml:"allowedIPs"`
+}